@@ -0,0 +1,86 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/text/language"
+
+	"github.com/HelloEveryboby/Butler/greet"
+	"github.com/HelloEveryboby/Butler/script"
+)
+
+// langFlags collects repeated --lang flags, in the order given.
+type langFlags []string
+
+func (l *langFlags) String() string     { return strings.Join(*l, ",") }
+func (l *langFlags) Set(v string) error { *l = append(*l, v); return nil }
+
+func main() {
+	var langs langFlags
+	flag.Var(&langs, "lang", "preferred language (BCP-47 tag, repeatable, highest priority first)")
+	scriptFile := flag.String("script", "", "path to a JavaScript file rendering the greeting")
+	scriptExpr := flag.String("script-expr", "", "inline JavaScript expression rendering the greeting")
+	flag.Parse()
+
+	name := "World"
+	if args := flag.Args(); len(args) > 0 {
+		name = strings.Join(args, " ")
+	}
+
+	if *scriptFile != "" || *scriptExpr != "" {
+		out, err := renderScript(*scriptFile, *scriptExpr, name)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Println(out)
+		return
+	}
+
+	fmt.Println(greet.Greet(name, preferences(langs)...))
+}
+
+// renderScript reads src from file (if set, else expr) and evaluates it
+// against name using the script package's Goja engine.
+func renderScript(file, expr, name string) (string, error) {
+	src := expr
+	if file != "" {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return "", err
+		}
+		src = string(data)
+	}
+	return script.NewEngine(0).Render(src, name)
+}
+
+// preferences builds the caller's language preference list, ordering
+// explicit --lang flags ahead of the LC_ALL/LANG environment variables.
+func preferences(langs []string) []language.Tag {
+	candidates := append([]string{}, langs...)
+	for _, env := range []string{"LC_ALL", "LANG"} {
+		if v := os.Getenv(env); v != "" {
+			candidates = append(candidates, localeToBCP47(v))
+		}
+	}
+
+	prefs := make([]language.Tag, 0, len(candidates))
+	for _, c := range candidates {
+		if tag, err := language.Parse(c); err == nil {
+			prefs = append(prefs, tag)
+		}
+	}
+	return prefs
+}
+
+// localeToBCP47 strips the encoding/modifier suffix from POSIX locale
+// strings such as "fr_FR.UTF-8" so they can be parsed as BCP-47 tags.
+func localeToBCP47(locale string) string {
+	if i := strings.IndexAny(locale, ".@"); i >= 0 {
+		locale = locale[:i]
+	}
+	return strings.ReplaceAll(locale, "_", "-")
+}
@@ -0,0 +1,51 @@
+// Package greet renders "Hello, <name>" style messages in the caller's
+// preferred language, chosen via BCP-47 matching over a small built-in
+// translation registry.
+package greet
+
+import (
+	"fmt"
+
+	"golang.org/x/text/language"
+)
+
+// Greeting pairs a BCP-47 language tag with its localized message template.
+// The template must contain exactly one %s verb for the recipient's name.
+type Greeting struct {
+	Tag  language.Tag
+	Text string
+}
+
+// registry lists the supported translations. English is first so that it
+// is also the matcher's fallback when none of the caller's preferences
+// match a supported language.
+var registry = []Greeting{
+	{language.English, "Hello, %s from Go!"},
+	{language.French, "Bonjour %s, de la part de Go !"},
+	{language.Spanish, "¡Hola %s, desde Go!"},
+	{language.German, "Hallo %s, von Go!"},
+	{language.Italian, "Ciao %s, da Go!"},
+	{language.Portuguese, "Olá %s, do Go!"},
+	{language.Russian, "Привет, %s, от Go!"},
+	{language.Japanese, "こんにちは、%sさん（Goより）"},
+	{language.Korean, "안녕하세요, %s님 (Go에서)"},
+	{language.SimplifiedChinese, "你好，%s，来自 Go！"},
+}
+
+var matcher = newMatcher()
+
+func newMatcher() language.Matcher {
+	tags := make([]language.Tag, len(registry))
+	for i, g := range registry {
+		tags[i] = g.Tag
+	}
+	return language.NewMatcher(tags)
+}
+
+// Greet returns the registry's message for name, localized to the best
+// match among prefs. When none of prefs match a supported language, it
+// falls back to English.
+func Greet(name string, prefs ...language.Tag) string {
+	_, idx, _ := matcher.Match(prefs...)
+	return fmt.Sprintf(registry[idx].Text, name)
+}
@@ -0,0 +1,44 @@
+package greet
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestGreet(t *testing.T) {
+	tests := []struct {
+		name  string
+		prefs []language.Tag
+		want  string
+	}{
+		{
+			name:  "english then french prefers english",
+			prefs: []language.Tag{language.AmericanEnglish, language.French},
+			want:  "Hello, World from Go!",
+		},
+		{
+			name:  "french then english prefers french",
+			prefs: []language.Tag{language.French, language.AmericanEnglish},
+			want:  "Bonjour World, de la part de Go !",
+		},
+		{
+			name:  "unknown language falls back to english",
+			prefs: []language.Tag{language.MustParse("zu")},
+			want:  "Hello, World from Go!",
+		},
+		{
+			name:  "no preferences falls back to english",
+			prefs: nil,
+			want:  "Hello, World from Go!",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Greet("World", tt.prefs...); got != tt.want {
+				t.Errorf("Greet(%q, %v) = %q, want %q", "World", tt.prefs, got, tt.want)
+			}
+		})
+	}
+}
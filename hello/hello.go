@@ -0,0 +1,41 @@
+// Package hello is a gomobile-bindable greeting API: plain functions and
+// structs with no os or fmt.Println side effects. Everything takes and
+// returns strings except GreetingsMany, whose []string parameter gomobile
+// cannot bind; package mobile wraps it with a bindable list type.
+package hello
+
+import (
+	"strings"
+
+	"github.com/HelloEveryboby/Butler/greet"
+)
+
+// Greetings returns the English greeting for name.
+func Greetings(name string) string {
+	return greet.Greet(name)
+}
+
+// GreetingsMany returns the English greeting for each name, one per line.
+func GreetingsMany(names []string) string {
+	lines := make([]string, len(names))
+	for i, n := range names {
+		lines[i] = Greetings(n)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Greeter renders greetings with a fixed prefix, e.g. for branding a
+// greeting before the generated message.
+type Greeter struct {
+	prefix string
+}
+
+// NewGreeter returns a Greeter that prepends prefix to every greeting.
+func NewGreeter(prefix string) *Greeter {
+	return &Greeter{prefix: prefix}
+}
+
+// Say renders the greeting for name with the Greeter's prefix.
+func (g *Greeter) Say(name string) string {
+	return g.prefix + Greetings(name)
+}
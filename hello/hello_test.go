@@ -0,0 +1,24 @@
+package hello
+
+import "testing"
+
+func TestGreetings(t *testing.T) {
+	if got, want := Greetings("World"), "Hello, World from Go!"; got != want {
+		t.Errorf("Greetings(%q) = %q, want %q", "World", got, want)
+	}
+}
+
+func TestGreetingsMany(t *testing.T) {
+	got := GreetingsMany([]string{"Alice", "Bob"})
+	want := "Hello, Alice from Go!\nHello, Bob from Go!"
+	if got != want {
+		t.Errorf("GreetingsMany(...) = %q, want %q", got, want)
+	}
+}
+
+func TestGreeterSay(t *testing.T) {
+	g := NewGreeter("[butler] ")
+	if got, want := g.Say("World"), "[butler] Hello, World from Go!"; got != want {
+		t.Errorf("Say(%q) = %q, want %q", "World", got, want)
+	}
+}
@@ -0,0 +1,66 @@
+// Package mobile provides gomobile bind-friendly wrappers around the hello
+// package's API. gomobile cannot bind []string directly, so StringList
+// stands in for it with an index-based wrapper that reduces cleanly to
+// Java's List<String> / Objective-C's NSArray<NSString *> bindings.
+package mobile
+
+import (
+	"fmt"
+
+	"github.com/HelloEveryboby/Butler/hello"
+)
+
+// StringList is a gomobile-bindable stand-in for []string.
+type StringList struct {
+	values []string
+}
+
+// NewStringList returns an empty StringList.
+func NewStringList() *StringList {
+	return &StringList{}
+}
+
+// Append adds name to the end of the list.
+func (l *StringList) Append(name string) {
+	l.values = append(l.values, name)
+}
+
+// Len returns the number of names in the list.
+func (l *StringList) Len() int {
+	return len(l.values)
+}
+
+// Get returns the name at index i. i must be in [0, Len()); gomobile
+// binds the error return as a Java exception / Objective-C NSError
+// instead of letting bound code trigger a Go panic.
+func (l *StringList) Get(i int) (string, error) {
+	if i < 0 || i >= len(l.values) {
+		return "", fmt.Errorf("mobile: StringList index %d out of range [0, %d)", i, len(l.values))
+	}
+	return l.values[i], nil
+}
+
+// Greetings returns the English greeting for name.
+func Greetings(name string) string {
+	return hello.Greetings(name)
+}
+
+// GreetingsMany returns the English greeting for every name in list.
+func GreetingsMany(list *StringList) string {
+	return hello.GreetingsMany(list.values)
+}
+
+// Greeter mirrors hello.Greeter for gomobile binding.
+type Greeter struct {
+	inner *hello.Greeter
+}
+
+// NewGreeter returns a Greeter that prepends prefix to every greeting.
+func NewGreeter(prefix string) *Greeter {
+	return &Greeter{inner: hello.NewGreeter(prefix)}
+}
+
+// Say renders the greeting for name with the Greeter's prefix.
+func (g *Greeter) Say(name string) string {
+	return g.inner.Say(name)
+}
@@ -0,0 +1,46 @@
+package mobile
+
+import "testing"
+
+func TestStringList(t *testing.T) {
+	l := NewStringList()
+	l.Append("Alice")
+	l.Append("Bob")
+
+	if got, want := l.Len(), 2; got != want {
+		t.Fatalf("Len() = %d, want %d", got, want)
+	}
+	got, err := l.Get(0)
+	if err != nil {
+		t.Fatalf("Get(0) returned error: %v", err)
+	}
+	if want := "Alice"; got != want {
+		t.Errorf("Get(0) = %q, want %q", got, want)
+	}
+
+	if _, err := l.Get(2); err == nil {
+		t.Error("Get(2) on a 2-element list: expected an out-of-range error, got nil")
+	}
+	if _, err := l.Get(-1); err == nil {
+		t.Error("Get(-1): expected an out-of-range error, got nil")
+	}
+}
+
+func TestGreetingsMany(t *testing.T) {
+	l := NewStringList()
+	l.Append("Alice")
+	l.Append("Bob")
+
+	got := GreetingsMany(l)
+	want := "Hello, Alice from Go!\nHello, Bob from Go!"
+	if got != want {
+		t.Errorf("GreetingsMany(...) = %q, want %q", got, want)
+	}
+}
+
+func TestGreeterSay(t *testing.T) {
+	g := NewGreeter("[butler] ")
+	if got, want := g.Say("World"), "[butler] Hello, World from Go!"; got != want {
+		t.Errorf("Say(%q) = %q, want %q", "World", got, want)
+	}
+}
@@ -0,0 +1,104 @@
+// Package script evaluates user-supplied JavaScript greeting templates in
+// an embedded Goja runtime. Runtimes are pooled to amortize VM setup when
+// Render is called many times, e.g. from a server mode.
+package script
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dop251/goja"
+
+	"github.com/HelloEveryboby/Butler/greet"
+	"golang.org/x/text/language"
+)
+
+// DefaultTimeout bounds how long a single Render call may run before its
+// VM is interrupted.
+const DefaultTimeout = 2 * time.Second
+
+// Engine evaluates greeting scripts in a sandboxed Goja runtime: only a
+// greet host function is registered, so scripts have no require/process
+// and cannot reach the filesystem, network, or OS process.
+type Engine struct {
+	Timeout time.Duration
+	pool    sync.Pool
+}
+
+// NewEngine returns an Engine that interrupts a Render call after timeout.
+// A timeout of zero or less uses DefaultTimeout.
+func NewEngine(timeout time.Duration) *Engine {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	e := &Engine{Timeout: timeout}
+	e.pool.New = func() interface{} { return newRuntime() }
+	return e
+}
+
+func newRuntime() *goja.Runtime {
+	vm := goja.New()
+	vm.Set("greet", func(name, lang string) string {
+		tag, err := language.Parse(lang)
+		if err != nil {
+			return greet.Greet(name)
+		}
+		return greet.Greet(name, tag)
+	})
+	return vm
+}
+
+// Render evaluates src as a JavaScript expression with the global `name`
+// bound to name, returning its string result.
+func (e *Engine) Render(src string, name string) (string, error) {
+	vm := e.pool.Get().(*goja.Runtime)
+
+	// Defense in depth: whatever left this runtime in the pool should
+	// already have cleared its own interrupt (see below), but a fresh
+	// call must never inherit a pending one.
+	vm.ClearInterrupt()
+
+	vm.Set("name", name)
+
+	// done gates whether the timer is still allowed to call vm.Interrupt.
+	// timer.Stop()'s return value can't be used for that: it only tells
+	// us whether the timer's goroutine had already started, not whether
+	// its call to vm.Interrupt has finished. Taking mu both there and
+	// here makes "is this call still live" an atomic check instead of a
+	// race between two independently scheduled goroutines touching the
+	// same pooled runtime.
+	var mu sync.Mutex
+	done := false
+	timer := time.AfterFunc(e.Timeout, func() {
+		mu.Lock()
+		defer mu.Unlock()
+		if !done {
+			vm.Interrupt("script execution timed out")
+		}
+	})
+
+	v, err := vm.RunString(src)
+
+	mu.Lock()
+	done = true
+	mu.Unlock()
+	timer.Stop()
+
+	// By the time the lock above is released, any Interrupt call the
+	// timer goroutine had already started is guaranteed to have
+	// completed, so it's now safe to clear it before the runtime goes
+	// back in the pool for an unrelated Render() call to draw.
+	vm.ClearInterrupt()
+	e.pool.Put(vm)
+
+	if err != nil {
+		var interrupted *goja.InterruptedError
+		if errors.As(err, &interrupted) {
+			return "", fmt.Errorf("script timed out after %s", e.Timeout)
+		}
+		return "", fmt.Errorf("script error: %w", err)
+	}
+	return v.String(), nil
+}
@@ -0,0 +1,118 @@
+package script
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dop251/goja"
+)
+
+func TestRenderSuccess(t *testing.T) {
+	e := NewEngine(0)
+	got, err := e.Render(`"Hello, " + name.toUpperCase() + " from JS!"`, "World")
+	if err != nil {
+		t.Fatalf("Render returned error: %v", err)
+	}
+	if want := "Hello, WORLD from JS!"; got != want {
+		t.Errorf("Render(...) = %q, want %q", got, want)
+	}
+}
+
+func TestRenderSyntaxError(t *testing.T) {
+	e := NewEngine(0)
+	if _, err := e.Render(`"unterminated`, "World"); err == nil {
+		t.Fatal("expected a syntax error, got nil")
+	}
+}
+
+func TestRenderRuntimeError(t *testing.T) {
+	e := NewEngine(0)
+	if _, err := e.Render(`undefinedFn()`, "World"); err == nil {
+		t.Fatal("expected a runtime error, got nil")
+	}
+}
+
+func TestRenderTimeout(t *testing.T) {
+	e := NewEngine(10 * time.Millisecond)
+	_, err := e.Render(`while (true) {}`, "World")
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Fatalf("expected a timeout error, got %v", err)
+	}
+}
+
+// TestRenderStrayInterruptDoesNotLeakAcrossPooledReuse sets a stray
+// interrupt by hand, without any concurrency: a runtime that goes back into the pool with
+// its interrupt flag still set (as a timer firing just after RunString
+// returned would leave it) must not poison the next, unrelated Render()
+// call that draws it from the pool.
+func TestRenderStrayInterruptDoesNotLeakAcrossPooledReuse(t *testing.T) {
+	e := NewEngine(50 * time.Millisecond)
+
+	if _, err := e.Render(`"Hello, " + name`, "Alice"); err != nil {
+		t.Fatalf("first Render returned error: %v", err)
+	}
+
+	// Simulate a timer callback that fired on this runtime after its own
+	// call had already finished and been returned to the pool.
+	vm := e.pool.Get().(*goja.Runtime)
+	vm.Interrupt("simulated stray interrupt from a late-firing timer")
+	e.pool.Put(vm)
+
+	got, err := e.Render(`"Hello, " + name`, "Bob")
+	if err != nil {
+		t.Fatalf("Render after a stray interrupt returned error: %v", err)
+	}
+	if want := "Hello, Bob"; got != want {
+		t.Errorf("Render(...) = %q, want %q", got, want)
+	}
+}
+
+// TestRenderConcurrentPoolReuse exercises pool reuse under overlapping
+// timeout and non-timeout renders concurrently. A render whose timer
+// legitimately fires (the whole call, including scheduling delay, ran
+// past the timeout) is an expected timeout, not a bug; this test instead
+// flags a render that errors out near-instantly, well within its budget,
+// since that shape of failure means it inherited a stray interrupt from
+// an unrelated call sharing the pool rather than timing out itself.
+func TestRenderConcurrentPoolReuse(t *testing.T) {
+	e := NewEngine(5 * time.Millisecond)
+
+	const slowWorkers = 8
+
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < slowWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				e.Render(`var x = 0; for (var i = 0; i < 4000000; i++) { x += i; }`, "Slow")
+			}
+		}()
+	}
+
+	deadline := time.Now().Add(1 * time.Second)
+	for time.Now().Before(deadline) {
+		start := time.Now()
+		got, err := e.Render(`"Hello, " + name`, "Fast")
+		elapsed := time.Since(start)
+		if err != nil {
+			if elapsed < e.Timeout/2 {
+				t.Fatalf("fast render failed after only %v (timeout %v): %v — looks like it inherited a stray interrupt from a pooled runtime", elapsed, e.Timeout, err)
+			}
+			continue // a genuine timeout caused by scheduling contention, not a bug
+		}
+		if want := "Hello, Fast"; got != want {
+			t.Fatalf("Render(...) = %q, want %q", got, want)
+		}
+	}
+	close(stop)
+	wg.Wait()
+}